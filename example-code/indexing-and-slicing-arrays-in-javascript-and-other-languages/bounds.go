@@ -0,0 +1,37 @@
+/* Copyright Jason R. Stevens, CFA
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+
+// bounds.go
+//
+// Side by side with the successful myArray[0:1] slice in slices.go, this
+// file shows what happens when a slice expression goes out of bounds: a
+// compile-time error for a constant, out-of-range index, and a runtime
+// panic (recovered here) for an index that's only known to be bad at
+// runtime.
+
+package main
+
+import "fmt"
+
+// A [0]int sliced at [2:] is a compile-time error, since the compiler
+// can prove the index is out of range for a fixed-size array:
+//
+//	var empty [0]int
+//	_ = empty[2:] // invalid argument: index 2 out of bounds [0:1]
+
+func init() {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Println("recovered:", r)
+		}
+	}()
+
+	myArray := [3]string{"testing", "array", "indexes"}
+	s := myArray[:]
+	n := 5
+	_ = s[0:n] // panics: runtime error: slice bounds out of range [:5] with capacity 3
+}
@@ -0,0 +1,34 @@
+//go:build go1.17
+
+/* Copyright Jason R. Stevens, CFA
+ *
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+
+// slice_to_array_ptr.go
+//
+// Go 1.17 added a conversion from a slice to an array pointer: (*[N]T)(s).
+// It panics at runtime if len(s) < N, since there aren't enough elements
+// to point at.
+
+package main
+
+import "fmt"
+
+func init() {
+	myArray := [3]string{"testing", "array", "indexes"}
+
+	arrp := (*[3]string)(myArray[:])
+	fmt.Println(*arrp)
+
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Println("recovered:", r)
+		}
+	}()
+
+	short := myArray[:2]
+	_ = (*[3]string)(short) // panics: len(short) == 2 < 3
+}
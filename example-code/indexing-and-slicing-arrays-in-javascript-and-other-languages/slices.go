@@ -11,9 +11,35 @@ package main
 
 import "fmt"
 
+// appendInt mimics the growth strategy of the builtin append: if there's
+// room in the backing array it reuses it, otherwise it allocates a new one
+// at (at least) double the previous capacity.
+func appendInt(x []int, y int) []int {
+	if len(x)+1 <= cap(x) {
+		x = x[:len(x)+1]
+	} else {
+		newCap := len(x) + 1
+		if 2*len(x) > newCap {
+			newCap = 2 * len(x)
+		}
+		newX := make([]int, len(x), newCap)
+		copy(newX, x)
+		x = newX[:len(x)+1]
+	}
+	x[len(x)-1] = y
+	return x
+}
+
 func main() {
 	myArray := [3]string{"testing", "array", "indexes"}
 
 	var s []string = myArray[0:1]
 	fmt.Println(s)
+
+	// Watch len/cap grow as appendInt reuses or reallocates the backing array.
+	var nums []int
+	for i := 0; i < 10; i++ {
+		nums = appendInt(nums, i)
+		fmt.Printf("len=%d cap=%d nums=%v\n", len(nums), cap(nums), nums)
+	}
 }